@@ -0,0 +1,158 @@
+/*
+Package game provides a pluggable N×N board engine for tic-tac-toe style
+games. The board is tracked as a pair of bitboards (one per player) instead
+of the original fixed [9]int array, so the same win-checking code works for
+the classic 3x3 game as well as larger K-in-a-row variants (4x4, 5x5
+Gomoku-style play, ...).
+*/
+package game
+
+import "math/bits"
+
+const (
+	Empty = 0  // empty cell
+	X     = -1 // first player (human in the original CLI)
+	O     = 1  // second player (AI in the original CLI)
+)
+
+// Board is the interface the minimax engine plays against. Implementations
+// are free to choose any backing representation as long as moves can be
+// listed, applied, undone and scored against the board's win masks.
+type Board interface {
+	Size() int
+	Occupied(player int) uint64
+	LegalMoves() []int
+	Place(cell, player int)
+	Remove(cell, player int)
+	WinMasks() []uint64
+}
+
+// BitBoard is the default Board implementation: an n*n grid backed by two
+// uint32 bitboards, one per player, plus a table of win masks precomputed
+// once for the board's (n, k) shape. Cell i corresponds to bit i, row-major.
+type BitBoard struct {
+	n, k     int
+	bitsX    uint32
+	bitsO    uint32
+	winMasks []uint64
+}
+
+// NewBitBoard builds an n*n board where k marks in a row (horizontally,
+// vertically or diagonally) win the game. Classic tic-tac-toe is
+// NewBitBoard(3, 3); NewBitBoard(5, 4) plays 5x5 "get 4 in a row".
+func NewBitBoard(n, k int) *BitBoard {
+	return &BitBoard{n: n, k: k, winMasks: buildWinMasks(n, k)}
+}
+
+func (b *BitBoard) Size() int { return b.n }
+
+// Occupied returns the bitboard of cells held by player, widened to uint64
+// so callers never have to think about the underlying storage width.
+func (b *BitBoard) Occupied(player int) uint64 {
+	if player == X {
+		return uint64(b.bitsX)
+	}
+	return uint64(b.bitsO)
+}
+
+// LegalMoves returns the indices of every empty cell, extracted from the
+// free-cell bitmask via the classic x & -x / x &= x-1 bit tricks.
+func (b *BitBoard) LegalMoves() []int {
+	full := uint32(1)<<uint(b.n*b.n) - 1
+	free := full &^ (b.bitsX | b.bitsO)
+	moves := make([]int, 0, bits.OnesCount32(free))
+	for free != 0 {
+		lsb := free & -free
+		moves = append(moves, bits.TrailingZeros32(lsb))
+		free &= free - 1
+	}
+	return moves
+}
+
+// Place marks cell as taken by player. The caller is responsible for only
+// placing on legal (empty) cells.
+func (b *BitBoard) Place(cell, player int) {
+	if player == X {
+		b.bitsX |= 1 << uint(cell)
+	} else {
+		b.bitsO |= 1 << uint(cell)
+	}
+}
+
+// Remove clears cell for player, undoing a Place during search.
+func (b *BitBoard) Remove(cell, player int) {
+	if player == X {
+		b.bitsX &^= 1 << uint(cell)
+	} else {
+		b.bitsO &^= 1 << uint(cell)
+	}
+}
+
+func (b *BitBoard) WinMasks() []uint64 { return b.winMasks }
+
+// buildWinMasks enumerates every horizontal, vertical and diagonal run of k
+// cells on an n*n grid. For the classic n=3, k=3 case this produces the
+// same eight lines a hand-written vertMask/horzMask/diagMask1/diagMask2
+// table would, just generated once instead of spelled out by hand.
+func buildWinMasks(n, k int) []uint64 {
+	var masks []uint64
+	line := func(cells []int) uint64 {
+		var m uint64
+		for _, c := range cells {
+			m |= 1 << uint(c)
+		}
+		return m
+	}
+	for r := 0; r < n; r++ {
+		for c := 0; c+k <= n; c++ {
+			cells := make([]int, k)
+			for i := 0; i < k; i++ {
+				cells[i] = r*n + c + i
+			}
+			masks = append(masks, line(cells)) // horizontal
+		}
+	}
+	for c := 0; c < n; c++ {
+		for r := 0; r+k <= n; r++ {
+			cells := make([]int, k)
+			for i := 0; i < k; i++ {
+				cells[i] = (r+i)*n + c
+			}
+			masks = append(masks, line(cells)) // vertical
+		}
+	}
+	for r := 0; r+k <= n; r++ {
+		for c := 0; c+k <= n; c++ {
+			cells := make([]int, k)
+			for i := 0; i < k; i++ {
+				cells[i] = (r+i)*n + c + i
+			}
+			masks = append(masks, line(cells)) // diagonal, top-left to bottom-right
+		}
+	}
+	for r := 0; r+k <= n; r++ {
+		for c := k - 1; c < n; c++ {
+			cells := make([]int, k)
+			for i := 0; i < k; i++ {
+				cells[i] = (r+i)*n + c - i
+			}
+			masks = append(masks, line(cells)) // diagonal, top-right to bottom-left
+		}
+	}
+	return masks
+}
+
+// Evaluate ANDs every win mask against each player's bitboard: a player
+// wins a mask when all of its bits are already set on their board.
+// Returns X or O on a win, Empty on a draw or an unfinished game.
+func Evaluate(b Board) int {
+	for _, mask := range b.WinMasks() {
+		if b.Occupied(X)&mask == mask {
+			return X
+		}
+		if b.Occupied(O)&mask == mask {
+			return O
+		}
+	}
+	return Empty
+}
@@ -0,0 +1,49 @@
+package game
+
+import "testing"
+
+func TestEvaluateDetectsWinsAndDraws(t *testing.T) {
+	cases := []struct {
+		name string
+		x    []int
+		o    []int
+		want int
+	}{
+		{"row", []int{0, 1, 2}, []int{3, 4}, X},
+		{"column", []int{0, 3, 6}, []int{1, 2}, X},
+		{"diagonal", []int{0, 4, 8}, []int{1, 2}, X},
+		{"anti-diagonal", []int{2, 4, 6}, []int{0, 1}, X},
+		{"O wins", []int{1, 2}, []int{0, 3, 6}, O},
+		{"unfinished", []int{0, 4}, []int{1}, Empty},
+		{"full board draw", []int{0, 1, 5, 6, 8}, []int{2, 3, 4, 7}, Empty},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			b := NewBitBoard(3, 3)
+			for _, cell := range c.x {
+				b.Place(cell, X)
+			}
+			for _, cell := range c.o {
+				b.Place(cell, O)
+			}
+			if got := Evaluate(b); got != c.want {
+				t.Errorf("Evaluate() = %d, want %d", got, c.want)
+			}
+		})
+	}
+}
+
+func TestLegalMovesExcludesOccupiedCells(t *testing.T) {
+	b := NewBitBoard(3, 3)
+	b.Place(0, X)
+	b.Place(4, O)
+	moves := b.LegalMoves()
+	if len(moves) != 7 {
+		t.Fatalf("LegalMoves() returned %d cells, want 7", len(moves))
+	}
+	for _, cell := range moves {
+		if cell == 0 || cell == 4 {
+			t.Errorf("LegalMoves() included occupied cell %d", cell)
+		}
+	}
+}
@@ -0,0 +1,148 @@
+/*
+Package net lets two players meet over TCP instead of sharing a terminal.
+Messages are small JSON objects, one per line, written with json.Encoder
+so no separate framing is needed. The wire board is always a flat [9]int
+like the original CLI used, regardless of how the caller represents it
+internally.
+*/
+package net
+
+import (
+	"encoding/json"
+	"net"
+	"sync"
+)
+
+// Message is the only shape exchanged over the wire. Which fields matter
+// depends on Type:
+//   - "hello":      Role      — sent once, right after a connection is accepted
+//   - "move":       Cell      — a player claims a cell
+//   - "state":      Board, Turn — the authoritative board and whose turn it is
+//   - "result":     Board, Winner — the match is over
+//   - "disconnect": (no fields) — the opponent's connection dropped mid-match
+type Message struct {
+	Type   string `json:"type"`
+	Role   string `json:"role,omitempty"`
+	Cell   int    `json:"cell,omitempty"`
+	Board  [9]int `json:"board,omitempty"`
+	Turn   int    `json:"turn,omitempty"`
+	Winner int    `json:"winner,omitempty"`
+}
+
+// Conn is one newline-delimited-JSON connection, with the role the host
+// assigned it on accept (or the role Dial learned from the host's hello).
+type Conn struct {
+	Role string
+
+	nc  net.Conn
+	enc *json.Encoder
+	dec *json.Decoder
+}
+
+func newConn(nc net.Conn) *Conn {
+	return &Conn{nc: nc, enc: json.NewEncoder(nc), dec: json.NewDecoder(nc)}
+}
+
+// Send writes m as one line of JSON. json.Encoder.Encode appends the
+// newline, so this is the newline-delimited framing the protocol wants.
+func (c *Conn) Send(m Message) error { return c.enc.Encode(m) }
+
+// Receive blocks for the next line of JSON and decodes it into a Message.
+func (c *Conn) Receive() (Message, error) {
+	var m Message
+	err := c.dec.Decode(&m)
+	return m, err
+}
+
+func (c *Conn) Close() error { return c.nc.Close() }
+
+// Hub accepts connections for a single hosted match: the first connection
+// becomes the remote opponent (role "O"); every connection after that is
+// a read-only spectator that receives "state"/"result" broadcasts.
+type Hub struct {
+	listener net.Listener
+	opponent chan *Conn
+
+	mu         sync.Mutex
+	spectators []*Conn
+	lastState  *Message
+}
+
+// Serve starts listening on addr and returns immediately; connections are
+// accepted on a background goroutine.
+func Serve(addr string) (*Hub, error) {
+	ln, err := net.Listen("tcp", addr)
+	if err != nil {
+		return nil, err
+	}
+	h := &Hub{listener: ln, opponent: make(chan *Conn, 1)}
+	go h.acceptLoop()
+	return h, nil
+}
+
+func (h *Hub) acceptLoop() {
+	assigned := false
+	for {
+		nc, err := h.listener.Accept()
+		if err != nil {
+			return
+		}
+		conn := newConn(nc)
+
+		h.mu.Lock()
+		if !assigned {
+			assigned = true
+			conn.Role = "O"
+			conn.Send(Message{Type: "hello", Role: "O"})
+			h.mu.Unlock()
+			h.opponent <- conn
+			continue
+		}
+		conn.Role = "spectator"
+		conn.Send(Message{Type: "hello", Role: "spectator"})
+		if h.lastState != nil {
+			conn.Send(*h.lastState) // resync a late joiner to the current board
+		}
+		h.spectators = append(h.spectators, conn)
+		h.mu.Unlock()
+	}
+}
+
+// Opponent blocks until the remote player connects and returns their Conn.
+func (h *Hub) Opponent() *Conn { return <-h.opponent }
+
+// Broadcast fans m out to every connected spectator, and remembers it (if
+// it's a "state" or "result") so future spectators can resync on connect.
+func (h *Hub) Broadcast(m Message) {
+	h.mu.Lock()
+	if m.Type == "state" || m.Type == "result" {
+		cp := m
+		h.lastState = &cp
+	}
+	spectators := append([]*Conn(nil), h.spectators...)
+	h.mu.Unlock()
+
+	for _, s := range spectators {
+		s.Send(m) // best-effort: a slow or dead spectator shouldn't block the match
+	}
+}
+
+func (h *Hub) Close() error { return h.listener.Close() }
+
+// Dial connects to a hosted match and waits for the host's hello message,
+// which tells the caller whether they are the opponent ("O") or a
+// spectator.
+func Dial(addr string) (*Conn, error) {
+	nc, err := net.Dial("tcp", addr)
+	if err != nil {
+		return nil, err
+	}
+	conn := newConn(nc)
+	hello, err := conn.Receive()
+	if err != nil {
+		conn.Close()
+		return nil, err
+	}
+	conn.Role = hello.Role
+	return conn, nil
+}
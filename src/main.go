@@ -0,0 +1,259 @@
+/*
+An implementation of Minimax AI Algorithm in Tic Tac Toe,
+using Golang. For education purpose.
+This program is available under GPL license.
+Author: monesonn
+Year: 2021
+Credit: https://github.com/Cledersonbc/tic-tac-toe-minimax
+	https://github.com/mabsoftware/minimax
+*/
+package main
+
+import (
+	"flag"
+	"fmt"
+	"math/rand"
+	"os"
+	"os/exec"
+	"runtime"
+	"time"
+
+	"github.com/monesonn/tic-tac-toe/game"
+	"github.com/monesonn/tic-tac-toe/tree"
+)
+
+const (
+	N = 3 // classic 3x3 board
+	K = 3 // 3-in-a-row wins
+)
+
+// gameTree is the full 3x3 game graph, built once at startup so play is a
+// table lookup instead of a live minimax search.
+var gameTree = tree.Build()
+
+// Difficulty changes how wide a pool of FindBest's best-to-worst
+// candidates pickAIMove draws from: lower difficulties widen the pool
+// and weight it by payoff for variety instead of always playing the
+// single best move.
+type Difficulty int
+
+const (
+	Easy Difficulty = iota
+	Medium
+	Hard
+	Perfect
+)
+
+// pool sizes the candidate pool FindBest samples from, weighted by
+// payoff — wider at lower difficulties, trading perfect play for
+// variety. Easy doesn't consult the tree at all. Perfect's pool of 1
+// leaves FindBest nothing to sample, so it always returns the best move.
+func (d Difficulty) pool() int {
+	switch d {
+	case Medium:
+		return 4
+	case Hard:
+		return 2
+	default: // Perfect
+		return 1
+	}
+}
+
+var clear map[string]func() // variable for clearScreen function
+
+func main() {
+	rand.Seed(time.Now().UnixNano())
+
+	serveAddr := flag.String("serve", "", "host a networked match on this address, e.g. :9000")
+	dialAddr := flag.String("dial", "", "join a networked match hosted at this address")
+	plain := flag.Bool("plain", false, "use the original plain-stdout interface instead of the terminal UI")
+	flag.Parse()
+
+	switch {
+	case *serveAddr != "":
+		playNetworked(*serveAddr, *plain)
+	case *dialAddr != "":
+		joinNetworked(*dialAddr, *plain)
+	default:
+		playLocal(*plain)
+	}
+}
+
+// newUI builds the Tui unless plain asks for the original Cli, falling
+// back to Cli if the terminal can't support tcell (e.g. no TTY).
+func newUI(plain bool) (UI, error) {
+	if plain {
+		return Cli{}, nil
+	}
+	return NewTui()
+}
+
+func playLocal(plain bool) {
+	fmt.Printf("Go tic-tac-toe with the minimax algorithm\n")
+	fmt.Printf("+---------------------------------+\n")
+	fmt.Printf("| Human: X\t\t\t  |\n")
+	fmt.Printf("| Machine: O\t\t\t  |\n")
+	fmt.Printf("+---------------------------------+\n")
+	firstMover := promptFirstMover()
+	difficulty := promptDifficulty()
+
+	ui, err := newUI(plain)
+	if err != nil {
+		fmt.Printf("could not start the terminal UI (%v); falling back to -plain\n", err)
+		ui = Cli{}
+	}
+
+	session := NewSession()
+	if sc, ok := ui.(SessionControl); ok {
+		sc.BindSession(session)
+	}
+	if firstMover == 2 {
+		session.Turn = game.O
+	}
+	session.OnMove = func(board [9]int) { ui.Render(boardFromArray(board)) }
+	session.OnControl = session.OnMove
+
+	winner := session.Play(map[int]PlayerFunc{
+		game.X: localPlayerFunc(ui),
+		game.O: aiPlayerFunc(game.O, difficulty),
+	})
+
+	ui.Close()
+	if _, ok := ui.(*Tui); ok {
+		printBoard(boardFromArray(session.Board)) // Close() wiped the Tui's alt screen; Cli already left the final board in scrollback
+	}
+	switch winner {
+	case game.O:
+		fmt.Printf("AI win.\n")
+	case game.X:
+		fmt.Printf("HUMAN win.\n")
+	default:
+		fmt.Printf("Draw.\n")
+	}
+	os.Exit(0)
+}
+
+func promptFirstMover() int {
+	firstMover := 0
+	for firstMover != 1 && firstMover != 2 {
+		fmt.Printf("Would you like to go first or second? [1 / 2] ")
+		fmt.Scanf("%d", &firstMover)
+	}
+	return firstMover
+}
+
+func promptPlayAsAI() bool {
+	fmt.Printf("Let the AI play for you? [y/n] ")
+	var answer string
+	fmt.Scanf("%s", &answer)
+	return answer == "y" || answer == "Y"
+}
+
+func promptDifficulty() Difficulty {
+	difficulty := Difficulty(-1)
+	for difficulty < Easy || difficulty > Perfect {
+		fmt.Printf("Choose a difficulty [1] Easy  [2] Medium  [3] Hard  [4] Perfect: ")
+		var choice int
+		fmt.Scanf("%d", &choice)
+		difficulty = Difficulty(choice - 1)
+	}
+	return difficulty
+}
+
+// pickAIMove looks up board's position in the precomputed game tree and
+// plays the move difficulty.pool() draws from — always the single best
+// move at Perfect, occasionally a weaker one at Medium/Hard for variety.
+// Easy never consults the tree and moves at random. mover is whichever
+// side (game.X or game.O) the AI is actually playing as; BestMove works
+// out from the piece counts alone which side opened the match, so mover
+// is only needed to tell it which of the two bitboards is the AI's own.
+func pickAIMove(board *game.BitBoard, mover int, difficulty Difficulty) int {
+	if difficulty == Easy {
+		moves := board.LegalMoves()
+		return moves[rand.Intn(len(moves))]
+	}
+	cell, ok := gameTree.BestMove(board.Occupied(mover), board.Occupied(-mover), difficulty.pool())
+	if !ok {
+		moves := board.LegalMoves()
+		return moves[rand.Intn(len(moves))]
+	}
+	return cell
+}
+
+func getSymbol(board *game.BitBoard, cell int) rune {
+	/* Render character for player for printBoard function
+	:param cell: index of the cell to render
+	:return: */
+	bit := uint64(1) << uint(cell)
+	switch {
+	case board.Occupied(game.X)&bit != 0: return 'X'
+	case board.Occupied(game.O)&bit != 0: return 'O'
+	default: return ' '
+	}
+}
+
+func getPlayerMove(board *game.BitBoard) int {
+	/* The Human plays choosing a valid move.
+	:param board: current state of the board
+	:return: the 0-based cell index chosen */
+	n := board.Size()
+	occupied := func(move int) bool {
+		bit := uint64(1) << uint(move-1)
+		return board.Occupied(game.X)&bit != 0 || board.Occupied(game.O)&bit != 0
+	}
+	var move int
+	fmt.Printf("Where would you like to move? [1 - %d] ", n*n)
+	fmt.Scanf("%d", &move)
+	fmt.Printf("\n")
+	for move < 1 || move > n*n || occupied(move) {
+		fmt.Printf("Invalid. ")
+		fmt.Scanf("%d", &move)
+		fmt.Printf("\n")
+	}
+	return move - 1
+}
+
+func printBoard(board *game.BitBoard) {
+	/* Print the board on console
+	:param board: current state of the board */
+	n := board.Size()
+	fmt.Printf("\n")
+	for r := 0; r < n; r++ {
+		fmt.Printf(" ")
+		for c := 0; c < n; c++ {
+			fmt.Printf("%c", getSymbol(board, r*n+c))
+			if c < n-1 { fmt.Printf(" | ") }
+		}
+		fmt.Printf(" \n")
+		if r < n-1 {
+			for c := 0; c < n; c++ { fmt.Printf("----") }
+			fmt.Printf("\n")
+		}
+	}
+	fmt.Printf("\n")
+}
+
+// Recognizing system for clear function
+func init() {
+	clear = make(map[string]func())
+	clear["linux"] = func() {
+		cmd := exec.Command("clear")
+		cmd.Stdout = os.Stdout
+		cmd.Run()
+	}
+	clear["windows"] = func() {
+		cmd := exec.Command("cmd", "/c", "cls")
+		cmd.Stdout = os.Stdout
+		cmd.Run()
+	}
+}
+
+// Clears the console. Cli is the only caller left now that Tui manages
+// its own screen; on a platform with no registered clear command (e.g.
+// macOS, the BSDs), it's a no-op rather than a panic, since -plain
+// should degrade to un-cleared scrollback instead of crashing.
+func clearScreen() {
+	if value, ok := clear[runtime.GOOS]; ok {
+		value()
+	}
+}
@@ -0,0 +1,160 @@
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/monesonn/tic-tac-toe/game"
+	tttnet "github.com/monesonn/tic-tac-toe/net"
+)
+
+// playNetworked hosts a match on addr: the local side plays X (human or
+// AI, same as offline play), the first remote connection plays O, and any
+// further connections are read-only spectators.
+func playNetworked(addr string, plain bool) {
+	hub, err := tttnet.Serve(addr)
+	if err != nil {
+		fmt.Printf("could not host on %s: %v\n", addr, err)
+		os.Exit(1)
+	}
+	defer hub.Close()
+
+	fmt.Printf("Hosting on %s. Waiting for an opponent...\n", addr)
+	opponent := hub.Opponent()
+	fmt.Printf("Opponent connected as O.\n")
+
+	ui, err := newUI(plain)
+	if err != nil {
+		fmt.Printf("could not start the terminal UI (%v); falling back to -plain\n", err)
+		ui = Cli{}
+	}
+
+	x := localPlayerFunc(ui)
+	if promptPlayAsAI() {
+		x = aiPlayerFunc(game.X, promptDifficulty())
+	}
+
+	session := NewSession()
+	if sc, ok := ui.(SessionControl); ok {
+		sc.BindSession(session)
+	}
+	session.OnMove = func(board [9]int) {
+		ui.Render(boardFromArray(board))
+		hub.Broadcast(tttnet.Message{Type: "state", Board: board, Turn: session.Turn})
+	}
+	session.OnControl = func(board [9]int) {
+		ui.Render(boardFromArray(board))
+		msg := tttnet.Message{Type: "state", Board: board, Turn: session.Turn}
+		opponent.Send(msg) // an undo/restart isn't covered by the per-turn protocol messages, so push it to the remote side directly
+		hub.Broadcast(msg)
+	}
+	winner := session.Play(map[int]PlayerFunc{
+		game.X: x,
+		game.O: remotePlayerFunc(opponent, hub),
+	})
+
+	if winner != Disconnected {
+		result := tttnet.Message{Type: "result", Board: session.Board, Winner: winner}
+		opponent.Send(result)
+		hub.Broadcast(result)
+	}
+
+	ui.Close()
+	if _, ok := ui.(*Tui); ok {
+		printBoard(boardFromArray(session.Board)) // Close() wiped the Tui's alt screen; Cli already left the final board in scrollback
+	}
+	if winner == Disconnected {
+		hub.Broadcast(tttnet.Message{Type: "disconnect"})
+		fmt.Printf("Opponent disconnected; ending the match.\n")
+		os.Exit(0)
+	}
+	announceWinner(winner)
+	os.Exit(0)
+}
+
+// joinNetworked connects to a hosted match as either the remote opponent
+// or, once a match already has one, a spectator.
+func joinNetworked(addr string, plain bool) {
+	conn, err := tttnet.Dial(addr)
+	if err != nil {
+		fmt.Printf("could not reach host at %s: %v\n", addr, err)
+		os.Exit(1)
+	}
+	defer conn.Close()
+
+	if conn.Role == "spectator" {
+		fmt.Printf("Connected as a spectator.\n")
+	} else {
+		fmt.Printf("Connected as %s.\n", conn.Role)
+	}
+	me := playerValue(conn.Role)
+
+	ui, err := newUI(plain)
+	if err != nil {
+		fmt.Printf("could not start the terminal UI (%v); falling back to -plain\n", err)
+		ui = Cli{}
+	}
+
+	for {
+		msg, err := conn.Receive()
+		if err != nil {
+			ui.Close()
+			fmt.Printf("connection closed.\n")
+			return
+		}
+		board := boardFromArray(msg.Board)
+		ui.Render(board)
+		switch msg.Type {
+		case "state":
+			if conn.Role != "spectator" && msg.Turn == me {
+				conn.Send(tttnet.Message{Type: "move", Cell: ui.Move(board)})
+			}
+		case "result":
+			ui.Close()
+			if _, ok := ui.(*Tui); ok {
+				printBoard(board) // Close() wiped the Tui's alt screen; Cli already left the final board in scrollback
+			}
+			announceWinner(msg.Winner)
+			return
+		case "disconnect":
+			ui.Close()
+			fmt.Printf("The other player disconnected; ending the match.\n")
+			return
+		}
+	}
+}
+
+// remotePlayerFunc turns a connection into a PlayerFunc: it pushes the
+// current board as a "state" message and waits for the reply "move".
+func remotePlayerFunc(conn *tttnet.Conn, hub *tttnet.Hub) PlayerFunc {
+	return func(board [9]int) int {
+		conn.Send(tttnet.Message{Type: "state", Board: board, Turn: game.O})
+		for {
+			msg, err := conn.Receive()
+			if err != nil {
+				return -1
+			}
+			if msg.Type == "move" {
+				return msg.Cell
+			}
+		}
+	}
+}
+
+func playerValue(role string) int {
+	if role == "X" {
+		return game.X
+	}
+	return game.O
+}
+
+func announceWinner(winner int) {
+	switch winner {
+	case game.X:
+		fmt.Printf("X win.\n")
+	case game.O:
+		fmt.Printf("O win.\n")
+	default:
+		fmt.Printf("Draw.\n")
+	}
+}
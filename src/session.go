@@ -0,0 +1,150 @@
+package main
+
+import (
+	"github.com/monesonn/tic-tac-toe/game"
+)
+
+// PlayerFunc asks something — a human, the AI, or a remote opponent — for
+// its next move given the board as it stands. Returning a 0-based cell
+// index it plays unifies all three so Session doesn't care which kind of
+// player it's driving.
+type PlayerFunc func(board [9]int) int
+
+// Disconnected is returned by Play in place of a winner when a
+// PlayerFunc can't supply a move at all — a remote opponent dropping
+// the connection, say — rather than ending the match with a panic or a
+// misleading win/draw.
+const Disconnected = 2
+
+// Session runs a classic 3x3 game to completion, independent of where
+// each side's moves come from. OnMove, if set, is called after every ply
+// (e.g. to rebroadcast the board to spectators). OnControl, if set, is
+// called instead by Undo, UndoRound and Reset: unlike a ply it isn't
+// attributed to whichever side is about to move next, so a networked
+// host uses it to push the rewound/reset board straight to the remote
+// opponent's own connection — something the per-turn "state" messages
+// (Turn-gated, assuming strict alternation) can't do on their own.
+type Session struct {
+	Board     [9]int
+	Turn      int
+	OnMove    func(board [9]int)
+	OnControl func(board [9]int)
+
+	history []int // cells played, in order, so Undo can unwind them
+}
+
+// NewSession starts an empty board with X to move.
+func NewSession() *Session {
+	return &Session{Turn: game.X}
+}
+
+// Play alternates players[s.Turn] until someone wins, the board fills,
+// or a PlayerFunc gives up or misbehaves — a negative cell (meaning "no
+// move"), one outside the board, or one that's already occupied are all
+// treated as Disconnected rather than trusted and indexed blindly, since
+// a remote opponent's "move" message is attacker-controlled input. The
+// mover for each ply is captured before players[mover] is called, so an
+// Undo or Reset the PlayerFunc triggers mid-call (e.g. from the Tui's
+// 'u'/'r' bindings) can't change who the resulting move is attributed
+// to; looping on len(s.history) rather than a fixed count means a
+// mid-game Reset is also reflected in how many plies are left to play.
+func (s *Session) Play(players map[int]PlayerFunc) int {
+	for len(s.history) < len(s.Board) {
+		mover := s.Turn
+		move := players[mover](s.Board)
+		if move < 0 || move >= len(s.Board) || s.Board[move] != game.Empty {
+			return Disconnected
+		}
+		s.Board[move] = mover
+		s.history = append(s.history, move)
+		if s.OnMove != nil {
+			s.OnMove(s.Board)
+		}
+		if winner := game.Evaluate(boardFromArray(s.Board)); winner != game.Empty {
+			return winner
+		}
+		s.Turn = -mover
+	}
+	return game.Empty
+}
+
+// undo1 unwinds the single most recent ply without notifying OnControl,
+// so UndoRound can apply a pair of undos atomically instead of exposing
+// the momentarily turn-inconsistent state in between. There's no
+// standalone single-ply Undo: popping only the opponent's reply while
+// mid-Play() would desync s.Turn from the mover Play() already captured
+// for the in-flight PlayerFunc call, which is exactly the bug UndoRound
+// exists to avoid.
+func (s *Session) undo1() bool {
+	if len(s.history) == 0 {
+		return false
+	}
+	last := s.history[len(s.history)-1]
+	s.history = s.history[:len(s.history)-1]
+	s.Board[last] = game.Empty
+	s.Turn = -s.Turn
+	return true
+}
+
+// UndoRound unwinds a full round trip — the opponent's reply and the
+// local player's own move before it — as one atomic step, so OnControl
+// only ever observes turn-aligned states. It's what the Tui's 'u'
+// binding calls: Move only ever blocks on the local player's own turn,
+// meaning the last ply in history at that point is always the
+// opponent's reply; popping just that one would hand the local player
+// an unanswered extra move and break turn alternation. Popping both
+// puts the local player back where they were right before their own
+// last move instead.
+func (s *Session) UndoRound() bool {
+	if !s.undo1() {
+		return false
+	}
+	s.undo1() // the local player's own move, if there was one
+	if s.OnControl != nil {
+		s.OnControl(s.Board)
+	}
+	return true
+}
+
+// Reset clears the board back to an empty, X-to-move state, and runs
+// OnControl for the same reason UndoRound does.
+func (s *Session) Reset() {
+	s.Board = [9]int{}
+	s.Turn = game.X
+	s.history = nil
+	if s.OnControl != nil {
+		s.OnControl(s.Board)
+	}
+}
+
+// boardFromArray replays a wire-format [9]int onto a fresh bitboard so it
+// can be evaluated, rendered, or fed to pickAIMove.
+func boardFromArray(arr [9]int) *game.BitBoard {
+	b := game.NewBitBoard(N, K)
+	for i, v := range arr {
+		if v == game.X || v == game.O {
+			b.Place(i, v)
+		}
+	}
+	return b
+}
+
+// localPlayerFunc asks ui for a move instead of placing one directly, so
+// the same Session plays the same whether ui is the plain Cli or the Tui.
+func localPlayerFunc(ui UI) PlayerFunc {
+	return func(board [9]int) int {
+		b := boardFromArray(board)
+		ui.Render(b)
+		return ui.Move(b)
+	}
+}
+
+// aiPlayerFunc wraps pickAIMove so the AI can sit on either side of a
+// Session the same way a human or a remote opponent would. mover is the
+// color (game.X or game.O) this particular AI seat is playing.
+func aiPlayerFunc(mover int, difficulty Difficulty) PlayerFunc {
+	return func(board [9]int) int {
+		b := boardFromArray(board)
+		return pickAIMove(b, mover, difficulty)
+	}
+}
@@ -0,0 +1,146 @@
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/gdamore/tcell/v2"
+
+	"github.com/monesonn/tic-tac-toe/game"
+)
+
+const (
+	originX, originY = 2, 2
+	cellW, cellH     = 4, 2
+)
+
+// Tui is a tcell-backed UI: the board is drawn as a real grid, the human
+// hovers cells with the arrow keys or the mouse and places with Enter or
+// a click. Unlike Cli it isn't limited to Linux/Windows, since tcell
+// handles macOS, the BSDs and Windows uniformly.
+type Tui struct {
+	screen  tcell.Screen
+	cursor  int
+	session *Session
+	size    int // board.Size(), tracked from the last draw() so cellAt agrees with it
+}
+
+// NewTui initializes a tcell screen for the default N*N board.
+func NewTui() (*Tui, error) {
+	screen, err := tcell.NewScreen()
+	if err != nil {
+		return nil, err
+	}
+	if err := screen.Init(); err != nil {
+		return nil, err
+	}
+	screen.EnableMouse()
+	screen.SetStyle(tcell.StyleDefault)
+	return &Tui{screen: screen}, nil
+}
+
+// BindSession lets 'u' (undo) and 'r' (restart) reach back into the game
+// being played; Move falls back to plain cursor/placement input without it.
+func (t *Tui) BindSession(s *Session) { t.session = s }
+
+func (t *Tui) Close() { t.screen.Fini() }
+
+func (t *Tui) Render(board *game.BitBoard) { t.draw(board) }
+
+// Move blocks until the player picks a legal cell (Enter or a click),
+// redrawing on every cursor move, resize, undo or restart along the way.
+func (t *Tui) Move(board *game.BitBoard) int {
+	for {
+		t.draw(board)
+		switch ev := t.screen.PollEvent().(type) {
+		case *tcell.EventResize:
+			t.screen.Sync()
+		case *tcell.EventMouse:
+			if ev.Buttons()&tcell.Button1 != 0 {
+				if cell, ok := t.cellAt(ev.Position()); ok {
+					t.cursor = cell
+					if legalCell(board, cell) {
+						return cell
+					}
+				}
+			}
+		case *tcell.EventKey:
+			switch {
+			case ev.Key() == tcell.KeyEscape || ev.Rune() == 'q':
+				t.Close()
+				os.Exit(0)
+			case ev.Key() == tcell.KeyEnter:
+				if legalCell(board, t.cursor) {
+					return t.cursor
+				}
+			case ev.Key() == tcell.KeyUp:
+				t.moveCursor(-board.Size(), board)
+			case ev.Key() == tcell.KeyDown:
+				t.moveCursor(board.Size(), board)
+			case ev.Key() == tcell.KeyLeft:
+				t.moveCursor(-1, board)
+			case ev.Key() == tcell.KeyRight:
+				t.moveCursor(1, board)
+			case ev.Rune() == 'u':
+				if t.session != nil && t.session.UndoRound() {
+					board = boardFromArray(t.session.Board)
+				}
+			case ev.Rune() == 'r':
+				if t.session != nil {
+					t.session.Reset()
+					board = boardFromArray(t.session.Board)
+				}
+			}
+		}
+	}
+}
+
+func (t *Tui) moveCursor(delta int, board *game.BitBoard) {
+	n := board.Size()
+	if (delta == 1 && t.cursor%n == n-1) || (delta == -1 && t.cursor%n == 0) {
+		return // already at a row edge, arrows don't wrap
+	}
+	next := t.cursor + delta
+	if next < 0 || next >= n*n {
+		return
+	}
+	t.cursor = next
+}
+
+func (t *Tui) cellAt(x, y int) (int, bool) {
+	col, row := (x-originX)/cellW, (y-originY)/cellH
+	if col < 0 || col >= t.size || row < 0 || row >= t.size {
+		return 0, false
+	}
+	return row*t.size + col, true
+}
+
+func (t *Tui) draw(board *game.BitBoard) {
+	t.screen.Clear()
+	n := board.Size()
+	t.size = n
+	for row := 0; row < n; row++ {
+		for col := 0; col < n; col++ {
+			cell := row*n + col
+			style := tcell.StyleDefault
+			if cell == t.cursor {
+				style = style.Reverse(true)
+			}
+			t.emit(originX+col*cellW, originY+row*cellH, style, fmt.Sprintf(" %c ", getSymbol(board, cell)))
+		}
+	}
+	t.emit(originX, originY+n*cellH+1, tcell.StyleDefault,
+		"arrows/click: move   enter: place   u: undo   r: restart   q: quit")
+	t.screen.Show()
+}
+
+func (t *Tui) emit(x, y int, style tcell.Style, s string) {
+	for i, r := range s {
+		t.screen.SetContent(x+i, y, r, nil, style)
+	}
+}
+
+func legalCell(board *game.BitBoard, cell int) bool {
+	bit := uint64(1) << uint(cell)
+	return board.Occupied(game.X)&bit == 0 && board.Occupied(game.O)&bit == 0
+}
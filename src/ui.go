@@ -0,0 +1,34 @@
+package main
+
+import "github.com/monesonn/tic-tac-toe/game"
+
+// UI renders the board and collects the human's next move. Cli keeps the
+// original clear-screen-and-Scanf behavior; Tui replaces it with a real
+// terminal grid driven by the keyboard or mouse.
+type UI interface {
+	Render(board *game.BitBoard)
+	Move(board *game.BitBoard) int
+	Close()
+}
+
+// SessionControl is implemented by UIs that can reach back into the
+// Session they're driving for controls that don't fit PlayerFunc's
+// board-only contract, such as undo and restart. Cli has no such
+// bindings and doesn't implement it.
+type SessionControl interface {
+	BindSession(s *Session)
+}
+
+// Cli is the original stdout-and-Scanf UI.
+type Cli struct{}
+
+func (Cli) Render(board *game.BitBoard) {
+	clearScreen()
+	printBoard(board)
+}
+
+func (Cli) Move(board *game.BitBoard) int {
+	return getPlayerMove(board)
+}
+
+func (Cli) Close() {}
@@ -0,0 +1,68 @@
+package tree
+
+// The 3x3 board has 8 symmetries (the dihedral group D4): the identity,
+// three rotations, and four reflections. perms[t][i] is the cell index
+// that cell i maps to under symmetry t; inverse[t] undoes it.
+var perms = buildPerms()
+var inverse = buildInverse(perms)
+
+// transforms lists each symmetry as a (row, col) -> (row, col) function
+// over a 3x3 grid; buildPerms flattens them into cell-index permutations.
+var transforms = [8]func(r, c int) (int, int){
+	func(r, c int) (int, int) { return r, c },         // identity
+	func(r, c int) (int, int) { return c, 2 - r },     // rotate 90
+	func(r, c int) (int, int) { return 2 - r, 2 - c }, // rotate 180
+	func(r, c int) (int, int) { return 2 - c, r },     // rotate 270
+	func(r, c int) (int, int) { return 2 - r, c },     // flip rows (horizontal mirror)
+	func(r, c int) (int, int) { return r, 2 - c },     // flip cols (vertical mirror)
+	func(r, c int) (int, int) { return c, r },         // transpose (main diagonal)
+	func(r, c int) (int, int) { return 2 - c, 2 - r }, // anti-transpose (anti-diagonal)
+}
+
+func buildPerms() [8][9]int {
+	var p [8][9]int
+	for t, f := range transforms {
+		for i := 0; i < 9; i++ {
+			r, c := f(i/3, i%3)
+			p[t][i] = r*3 + c
+		}
+	}
+	return p
+}
+
+func buildInverse(p [8][9]int) [8][9]int {
+	var inv [8][9]int
+	for t := range p {
+		for i, j := range p[t] {
+			inv[t][j] = i
+		}
+	}
+	return inv
+}
+
+// transformState re-maps every X/O bit of a packed state through perms[t].
+func transformState(state uint32, t int) uint32 {
+	var out uint32
+	for i := 0; i < 9; i++ {
+		j := uint(perms[t][i])
+		if state&(1<<uint(i)) != 0 {
+			out |= 1 << j // X bit
+		}
+		if state&(1<<uint(9+i)) != 0 {
+			out |= 1 << (9 + j) // O bit
+		}
+	}
+	return out
+}
+
+// canonical returns the lexicographically smallest packed state among the
+// 8 symmetries of state, along with which symmetry produced it.
+func canonical(state uint32) (canon uint32, transform int) {
+	canon, transform = state, 0
+	for t := 1; t < 8; t++ {
+		if s := transformState(state, t); s < canon {
+			canon, transform = s, t
+		}
+	}
+	return canon, transform
+}
@@ -0,0 +1,275 @@
+/*
+Package tree precomputes the full 3x3 tic-tac-toe game graph so play
+becomes a table lookup instead of a live minimax search. Positions are
+packed into a single uint32 (bits 0-8 for X, bits 9-17 for O) and folded
+under the board's 8 D4 symmetries (rotations + reflections) before being
+used as a map key, which keeps the table down to the ~765 canonical
+states a 3x3 board actually has.
+*/
+package tree
+
+import (
+	"encoding/gob"
+	"io"
+	"math/bits"
+	"math/rand"
+	"sort"
+
+	"github.com/monesonn/tic-tac-toe/game"
+)
+
+// Outcome is the game-theoretic result of a position under optimal play.
+type Outcome int
+
+const (
+	Playing Outcome = iota
+	WinX
+	WinO
+	Draw
+)
+
+// Move is a single node of the precomputed game graph: a canonical board
+// state, the canonical states reached by playing into each of the 9 cells
+// (0 where that cell is occupied or otherwise unreachable), how many plies
+// deep the state is, and its outcome under optimal play from here on.
+type Move struct {
+	State   uint32
+	Next    [9]uint32
+	Depth   int
+	Outcome Outcome
+}
+
+// Choice is what FindBest returns: a cell to play and the weight it was
+// picked with, 10-depth so faster wins and slower losses score higher.
+type Choice struct {
+	Move   int
+	Weight int
+}
+
+// Table is the full graph, keyed by each position's canonical form.
+type Table map[uint32]*Move
+
+// winMasks is reused from the bitboard engine so the 3x3 win conditions
+// only have to be defined in one place.
+var winMasks = game.NewBitBoard(3, 3).WinMasks()
+
+// Build enumerates every legal 3x3 position reachable from an empty board
+// with X to move first, and returns the resulting canonical-state table.
+func Build() Table {
+	t := make(Table)
+	buildState(t, 0, 0, game.X)
+	return t
+}
+
+func buildState(t Table, state uint32, depth int, player int) *Move {
+	canon, _ := canonical(state)
+	if m, ok := t[canon]; ok {
+		return m
+	}
+	m := &Move{State: canon, Depth: depth}
+	t[canon] = m // insert before recursing: guards re-entry through a transposition
+
+	// From here on, work entirely in canon's own coordinate frame: Next's
+	// cell indices have to line up with the bits of m.State, and state
+	// itself may be some other symmetry of canon if this node was first
+	// reached through a transposition.
+	if outcome := outcomeOf(canon); outcome != Playing {
+		m.Outcome = outcome
+		return m
+	}
+	moves := legalMoves(canon)
+	if len(moves) == 0 {
+		m.Outcome = Draw
+		return m
+	}
+
+	bestScore := -1 << 30
+	for _, cell := range moves {
+		child := buildState(t, place(canon, cell, player), depth+1, -player)
+		m.Next[cell] = child.State
+		if s := score(child.Outcome, player, child.Depth); s > bestScore {
+			bestScore = s
+			m.Outcome = child.Outcome
+		}
+	}
+	return m
+}
+
+// FindBest looks at node's children in t and returns one, weighted by
+// how quickly it wins (or how slowly it loses), from the perspective of
+// whichever side is to move: O if maximize, X otherwise. pool caps how
+// many of the best-to-worst candidates are even in play; among those,
+// one is drawn at random with odds proportional to how much better its
+// Weight is than the pool's worst, so pool 1 (Perfect) always returns
+// the single best move, and a wider pool trades optimality for variety
+// without making a near-worst candidate as likely as a clearly-better
+// one. Since score is always "how good for player" regardless of which
+// player, the best move is always the highest-scoring one, so the sort
+// order doesn't depend on maximize.
+func (t Table) FindBest(node *Move, maximize bool, pool int) Choice {
+	player := game.X
+	if maximize {
+		player = game.O
+	}
+	var choices []Choice
+	for cell := 0; cell < 9; cell++ {
+		next := node.Next[cell]
+		if next == 0 {
+			continue
+		}
+		child, ok := t[next]
+		if !ok {
+			continue
+		}
+		choices = append(choices, Choice{Move: cell, Weight: score(child.Outcome, player, child.Depth)})
+	}
+	sort.Slice(choices, func(i, j int) bool {
+		return choices[i].Weight > choices[j].Weight
+	})
+	if pool < 1 {
+		pool = 1
+	}
+	if pool > len(choices) {
+		pool = len(choices)
+	}
+	choices = choices[:pool]
+
+	worst := choices[len(choices)-1].Weight
+	total := 0
+	for _, c := range choices {
+		total += c.Weight - worst + 1 // +1 so even the worst choice keeps some odds
+	}
+	draw := rand.Intn(total)
+	for _, c := range choices {
+		draw -= c.Weight - worst + 1
+		if draw < 0 {
+			return c
+		}
+	}
+	return choices[0] // unreachable: the loop above always exhausts draw first
+}
+
+// Lookup canonicalizes a raw (non-canonical) packed state and returns its
+// Move node along with the symmetry index used to reach the canonical
+// form. Pass that index to ToRawCell to translate a Choice.Move picked
+// against the canonical node back into the caller's own orientation.
+func (t Table) Lookup(state uint32) (node *Move, transform int) {
+	canon, ti := canonical(state)
+	return t[canon], ti
+}
+
+// ToRawCell undoes the symmetry applied during Lookup, mapping a cell
+// index that is valid in the canonical orientation back to the one the
+// caller's own (pre-canonicalization) board uses.
+func ToRawCell(cell, transform int) int { return inverse[transform][cell] }
+
+// Pack combines a player's X and O bitboards (as produced by
+// game.BitBoard.Occupied) into the single uint32 key this package uses.
+func Pack(bitsX, bitsO uint64) uint32 { return uint32(bitsX) | uint32(bitsO)<<9 }
+
+// BestMove recommends a cell for the side about to move, given its own
+// bitboard and the opponent's; pool is passed straight through to
+// FindBest to widen how many candidates are in play for variety. Build
+// only ever grows the tree from an empty board with X moving
+// first, so every node's Next[] is keyed on the assumption that equal
+// X/O counts means X is to move, and one more O than X means O is to
+// move. Which real color (game.X or game.O) is on which side of that
+// assumption depends on who actually opened the match, not on the
+// literal color of bitsMover — a mover with as many pieces as its
+// opponent is always standing in for the tree's X, and a mover one piece
+// behind is always standing in for its O, regardless of which real color
+// either of them is. Pack is called with whichever of the two bitboards
+// has the larger (or equal) popcount in the X slot, so a single table
+// serves a match either side opened. ok is false if bitsMover and
+// bitsOther don't describe a state the table ever reached.
+func (t Table) BestMove(bitsMover, bitsOther uint64, pool int) (cell int, ok bool) {
+	maximize := bits.OnesCount64(bitsMover) < bits.OnesCount64(bitsOther)
+	bitsX, bitsO := bitsMover, bitsOther
+	if maximize {
+		bitsX, bitsO = bitsOther, bitsMover
+	}
+	node, transform := t.Lookup(Pack(bitsX, bitsO))
+	if node == nil {
+		return 0, false
+	}
+	choice := t.FindBest(node, maximize, pool)
+	return ToRawCell(choice.Move, transform), true
+}
+
+// LoadTable reads back a table saved by SaveTable.
+func LoadTable(r io.Reader) (Table, error) {
+	var t Table
+	if err := gob.NewDecoder(r).Decode(&t); err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+// SaveTable gob-encodes t so a future run can skip calling Build again.
+func SaveTable(w io.Writer, t Table) error {
+	return gob.NewEncoder(w).Encode(t)
+}
+
+func value(outcome Outcome, player int) int {
+	switch outcome {
+	case WinX:
+		if player == game.X {
+			return 1
+		}
+		return -1
+	case WinO:
+		if player == game.O {
+			return 1
+		}
+		return -1
+	default:
+		return 0
+	}
+}
+
+// score weights an outcome the way the classic minimax tie-break does:
+// a win scores higher the sooner it arrives, a loss scores higher (less
+// negative) the longer it's delayed, and a draw is always neutral.
+func score(outcome Outcome, player, depth int) int {
+	switch value(outcome, player) {
+	case 1:
+		return 10 - depth
+	case -1:
+		return depth - 10
+	default:
+		return 0
+	}
+}
+
+func legalMoves(state uint32) []int {
+	occupied := (state | (state >> 9)) & 0x1FF
+	moves := make([]int, 0, 9)
+	for i := 0; i < 9; i++ {
+		if occupied&(1<<uint(i)) == 0 {
+			moves = append(moves, i)
+		}
+	}
+	return moves
+}
+
+func place(state uint32, cell, player int) uint32 {
+	if player == game.X {
+		return state | 1<<uint(cell)
+	}
+	return state | 1<<uint(9+cell)
+}
+
+func outcomeOf(state uint32) Outcome {
+	x := state & 0x1FF
+	o := (state >> 9) & 0x1FF
+	for _, mask := range winMasks {
+		m := uint32(mask)
+		if m&x == m {
+			return WinX
+		}
+		if m&o == m {
+			return WinO
+		}
+	}
+	return Playing
+}
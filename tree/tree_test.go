@@ -0,0 +1,82 @@
+package tree
+
+import (
+	"testing"
+
+	"github.com/monesonn/tic-tac-toe/game"
+)
+
+func TestCanonicalIsSymmetryInvariant(t *testing.T) {
+	// O in the center, X in a corner: every symmetry of this state must
+	// canonicalize to the same form, since canonical is meant to fold the
+	// 8 equivalent boards down to one table entry.
+	state := Pack(1<<0, 1<<4) // X@0, O@4 (center)
+	want, _ := canonical(state)
+	for t2 := 0; t2 < 8; t2++ {
+		if got, _ := canonical(transformState(state, t2)); got != want {
+			t.Errorf("canonical(transformState(state, %d)) = %#x, want %#x", t2, got, want)
+		}
+	}
+}
+
+func TestToRawCellUndoesLookupTransform(t *testing.T) {
+	// ToRawCell(cell, transform) must invert whatever canonical's own
+	// transform applied, so a canonical-orientation cell maps back to
+	// the exact raw cell that produced it.
+	state := Pack(1<<0, 1<<4) // X@0, O@4
+	_, transform := canonical(state)
+	for cell := 0; cell < 9; cell++ {
+		raw := ToRawCell(cell, transform)
+		if perms[transform][raw] != cell {
+			t.Errorf("ToRawCell(%d, %d) = %d, which perms[%d] maps to %d, want %d", cell, transform, raw, transform, perms[transform][raw], cell)
+		}
+	}
+}
+
+// playPerfect runs a full self-play game with both sides always picking
+// FindBest's single best move (pool 1), starting with firstMover to move
+// on an empty board. This is the regression test for the chunk0-2 bug:
+// Perfect play must never lose, regardless of which side opens.
+func playPerfect(t *testing.T, tbl Table, firstMover int) int {
+	t.Helper()
+	b := game.NewBitBoard(3, 3)
+	mover := firstMover
+	for i := 0; i < 9; i++ {
+		if winner := game.Evaluate(b); winner != game.Empty {
+			return winner
+		}
+		if len(b.LegalMoves()) == 0 {
+			return game.Empty
+		}
+		cell, ok := tbl.BestMove(b.Occupied(mover), b.Occupied(-mover), 1)
+		if !ok {
+			t.Fatalf("BestMove found no move for mover %d at ply %d", mover, i)
+		}
+		b.Place(cell, mover)
+		mover = -mover
+	}
+	return game.Evaluate(b)
+}
+
+func TestPerfectPlayNeverLosesEitherFirstMover(t *testing.T) {
+	tbl := Build()
+	for _, firstMover := range []int{game.X, game.O} {
+		if winner := playPerfect(t, tbl, firstMover); winner != game.Empty {
+			t.Errorf("perfect play with firstMover=%d ended in a win for %d, want a draw", firstMover, winner)
+		}
+	}
+}
+
+func TestFindBestPoolOneIsDeterministic(t *testing.T) {
+	tbl := Build()
+	node, transform := tbl.Lookup(Pack(0, 0)) // empty board, X to move
+	if node == nil {
+		t.Fatal("Lookup returned no node for the empty board")
+	}
+	first := ToRawCell(tbl.FindBest(node, false, 1).Move, transform)
+	for i := 0; i < 20; i++ {
+		if got := ToRawCell(tbl.FindBest(node, false, 1).Move, transform); got != first {
+			t.Fatalf("FindBest(pool=1) returned %d, want the same %d every time", got, first)
+		}
+	}
+}